@@ -0,0 +1,217 @@
+package colors
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Role names a semantic slot in a colorscheme, so components pull
+// "the border color" instead of hardcoding an escape sequence.
+type Role string
+
+const (
+	RoleBorder       Role = "border"
+	RoleActiveBorder Role = "active_border"
+	RoleText         Role = "text"
+	RoleSelection    Role = "selection"
+	RoleAccent       Role = "accent"
+	RoleWarning      Role = "warning"
+	RoleError        Role = "error"
+	RoleBackground   Role = "background"
+)
+
+// Theme holds the raw color spec for each Role, resolved into an
+// escape sequence on demand by FG/BG so the same spec can back either
+// register (RoleBackground would otherwise only ever be usable as a
+// foreground color).
+type Theme struct {
+	specs map[Role]string
+}
+
+// FG returns the SGR escape sequence that sets the foreground to
+// role's color.
+func (t *Theme) FG(role Role) string {
+	code, _ := colorSpec(38, t.specs[role])
+	return code
+}
+
+// BG mirrors FG for the background SGR parameter.
+func (t *Theme) BG(role Role) string {
+	code, _ := colorSpec(48, t.specs[role])
+	return code
+}
+
+func hasTruecolor() bool {
+	ct := strings.ToLower(os.Getenv("COLORTERM"))
+	return ct == "truecolor" || ct == "24bit"
+}
+
+// ansiNames maps the 16 standard ANSI color names to their xterm-256
+// index equivalents (0-15), so a name resolves through the same
+// "38/48;5;N" form as an explicit index.
+var ansiNames = map[string]int{
+	"black":         0,
+	"red":           1,
+	"green":         2,
+	"yellow":        3,
+	"blue":          4,
+	"magenta":       5,
+	"cyan":          6,
+	"white":         7,
+	"brightblack":   8,
+	"brightred":     9,
+	"brightgreen":   10,
+	"brightyellow":  11,
+	"brightblue":    12,
+	"brightmagenta": 13,
+	"brightcyan":    14,
+	"brightwhite":   15,
+}
+
+// colorSpec turns an ANSI name, a 256-color index string, or a
+// "#rrggbb" hex string into an escape sequence for the given SGR base
+// (38 for foreground, 48 for background), downsampling hex to the
+// nearest xterm-256 index when the terminal doesn't advertise truecolor.
+func colorSpec(base int, spec string) (string, error) {
+	spec = strings.TrimSpace(spec)
+	if strings.HasPrefix(spec, "#") {
+		r, g, b, err := parseHex(spec)
+		if err != nil {
+			return "", err
+		}
+		if hasTruecolor() {
+			return fmt.Sprintf("\x1b[%d;2;%d;%d;%dm", base, r, g, b), nil
+		}
+		return fmt.Sprintf("\x1b[%d;5;%dm", base, nearestXterm256(r, g, b)), nil
+	}
+	if n, err := strconv.Atoi(spec); err == nil {
+		return fmt.Sprintf("\x1b[%d;5;%dm", base, n), nil
+	}
+	if idx, ok := ansiNames[strings.ToLower(spec)]; ok {
+		return fmt.Sprintf("\x1b[%d;5;%dm", base, idx), nil
+	}
+	return "", fmt.Errorf("colors: unknown color %q", spec)
+}
+
+// resolveColor is colorSpec for the foreground register, used to
+// resolve the color specs in a colorscheme file.
+func resolveColor(spec string) (string, error) {
+	return colorSpec(38, spec)
+}
+
+// Reset clears all SGR attributes applied by FG, BG, or a Theme color.
+const Reset = "\x1b[0m"
+
+// FG returns the SGR escape sequence that sets the foreground to spec
+// (an ANSI name, a 256-color index, or a "#rrggbb" hex string). An
+// empty or unrecognized spec resolves to "", so widgets can pass a
+// zero-value color field straight through without a separate check.
+func FG(spec string) string {
+	code, err := colorSpec(38, spec)
+	if err != nil {
+		return ""
+	}
+	return code
+}
+
+// BG mirrors FG for the background SGR parameter.
+func BG(spec string) string {
+	code, err := colorSpec(48, spec)
+	if err != nil {
+		return ""
+	}
+	return code
+}
+
+func parseHex(s string) (r, g, b int, err error) {
+	s = strings.TrimPrefix(s, "#")
+	if len(s) != 6 {
+		return 0, 0, 0, fmt.Errorf("colors: invalid hex color %q", "#"+s)
+	}
+	v, err := strconv.ParseInt(s, 16, 32)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("colors: invalid hex color %q", "#"+s)
+	}
+	return int(v >> 16 & 0xff), int(v >> 8 & 0xff), int(v & 0xff), nil
+}
+
+// xterm256Levels are the 6 intensity steps used by the 216-color cube
+// in the standard xterm-256 palette (indices 16-231).
+var xterm256Levels = []int{0, 95, 135, 175, 215, 255}
+
+func nearestXterm256(r, g, b int) int {
+	nearest := func(v int) int {
+		best, bestDiff := 0, 1<<30
+		for i, level := range xterm256Levels {
+			diff := v - level
+			if diff < 0 {
+				diff = -diff
+			}
+			if diff < bestDiff {
+				best, bestDiff = i, diff
+			}
+		}
+		return best
+	}
+	ri, gi, bi := nearest(r), nearest(g), nearest(b)
+	return 16 + 36*ri + 6*gi + bi
+}
+
+// LoadTheme reads a TOML or JSON colorscheme file (by extension) mapping
+// role names to color specs, validating each spec eagerly so a bad
+// colorscheme file fails at load time rather than at first paint.
+func LoadTheme(path string) (*Theme, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var specs map[string]string
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		if err := json.Unmarshal(raw, &specs); err != nil {
+			return nil, fmt.Errorf("colors: parsing %s: %w", path, err)
+		}
+	case ".toml":
+		specs, err = parseSimpleTOML(raw)
+		if err != nil {
+			return nil, fmt.Errorf("colors: parsing %s: %w", path, err)
+		}
+	default:
+		return nil, fmt.Errorf("colors: unsupported theme format %q", filepath.Ext(path))
+	}
+
+	theme := &Theme{specs: make(map[Role]string, len(specs))}
+	for name, spec := range specs {
+		if _, err := resolveColor(spec); err != nil {
+			return nil, err
+		}
+		theme.specs[Role(name)] = spec
+	}
+	return theme, nil
+}
+
+// parseSimpleTOML handles the flat `role = "spec"` tables a colorscheme
+// file needs, without pulling in a full TOML dependency.
+func parseSimpleTOML(raw []byte) (map[string]string, error) {
+	specs := make(map[string]string)
+	for i, line := range strings.Split(string(raw), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "[") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value", i+1)
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+		value = strings.Trim(value, `"`)
+		specs[key] = value
+	}
+	return specs, nil
+}
@@ -0,0 +1,80 @@
+package colors
+
+import "testing"
+
+func TestParseHex(t *testing.T) {
+	r, g, b, err := parseHex("#ff5f87")
+	if err != nil {
+		t.Fatalf("parseHex returned error: %v", err)
+	}
+	if r != 0xff || g != 0x5f || b != 0x87 {
+		t.Errorf("parseHex(#ff5f87) = %d,%d,%d, want 255,95,135", r, g, b)
+	}
+
+	if _, _, _, err := parseHex("#abc"); err == nil {
+		t.Error("parseHex(#abc) should have failed on a short hex string")
+	}
+}
+
+func TestNearestXterm256(t *testing.T) {
+	if idx := nearestXterm256(0xff, 0x5f, 0x87); idx != 204 {
+		t.Errorf("nearestXterm256(255,95,135) = %d, want 204", idx)
+	}
+	if idx := nearestXterm256(0, 0, 0); idx != 16 {
+		t.Errorf("nearestXterm256(0,0,0) = %d, want 16", idx)
+	}
+}
+
+func TestColorSpec(t *testing.T) {
+	if code, err := colorSpec(38, "red"); err != nil || code != "\x1b[38;5;1m" {
+		t.Errorf("colorSpec(38, red) = %q, %v", code, err)
+	}
+	if code, err := colorSpec(48, "196"); err != nil || code != "\x1b[48;5;196m" {
+		t.Errorf("colorSpec(48, 196) = %q, %v", code, err)
+	}
+	if _, err := colorSpec(38, "not-a-color"); err == nil {
+		t.Error("colorSpec should reject an unknown color name")
+	}
+}
+
+func TestFGAndBG(t *testing.T) {
+	if got := FG(""); got != "" {
+		t.Errorf("FG(\"\") = %q, want \"\"", got)
+	}
+	if got := FG("black"); got != "\x1b[38;5;0m" {
+		t.Errorf("FG(black) = %q, want \\x1b[38;5;0m", got)
+	}
+	if got := BG("white"); got != "\x1b[48;5;7m" {
+		t.Errorf("BG(white) = %q, want \\x1b[48;5;7m", got)
+	}
+}
+
+func TestThemeFGAndBGUseDistinctRegisters(t *testing.T) {
+	theme := &Theme{specs: map[Role]string{RoleBackground: "blue"}}
+
+	if got := theme.FG(RoleBackground); got != "\x1b[38;5;4m" {
+		t.Errorf("theme.FG(RoleBackground) = %q, want \\x1b[38;5;4m", got)
+	}
+	if got := theme.BG(RoleBackground); got != "\x1b[48;5;4m" {
+		t.Errorf("theme.BG(RoleBackground) = %q, want \\x1b[48;5;4m", got)
+	}
+	if got := theme.FG(RoleBorder); got != "" {
+		t.Errorf("theme.FG for an unset role = %q, want \"\"", got)
+	}
+}
+
+func TestParseSimpleTOML(t *testing.T) {
+	src := []byte(`
+# a colorscheme
+[theme]
+border = "#ff5f87"
+text = "white"
+`)
+	specs, err := parseSimpleTOML(src)
+	if err != nil {
+		t.Fatalf("parseSimpleTOML returned error: %v", err)
+	}
+	if specs["border"] != "#ff5f87" || specs["text"] != "white" {
+		t.Errorf("parseSimpleTOML = %#v", specs)
+	}
+}
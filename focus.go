@@ -0,0 +1,128 @@
+package osui
+
+// Focusable is implemented by components that opt in to keyboard focus.
+// The FocusManager walks the layout tree on every render and collects
+// every component whose Focusable method returns true, in tree order.
+type Focusable interface {
+	Focusable() bool
+}
+
+// FocusManager tracks which focusable component currently receives
+// keyboard events and cycles between them on Tab/Shift-Tab.
+type FocusManager struct {
+	order   []Component
+	current int
+}
+
+func newFocusManager() *FocusManager {
+	return &FocusManager{current: -1}
+}
+
+// collect rebuilds the focus order from the layout tree rooted at root,
+// preserving the current selection when the same component is still
+// present.
+func (fm *FocusManager) collect(root Component) {
+	current := fm.Current()
+
+	fm.order = fm.order[:0]
+	var walk func(Component)
+	walk = func(c Component) {
+		if f, ok := c.(Focusable); ok && f.Focusable() {
+			fm.order = append(fm.order, c)
+		}
+		if container, ok := c.(Container); ok {
+			for _, child := range container.Children() {
+				walk(child)
+			}
+		}
+	}
+	walk(root)
+
+	fm.current = -1
+	for i, c := range fm.order {
+		if c == current {
+			fm.current = i
+			break
+		}
+	}
+	if fm.current == -1 && len(fm.order) > 0 {
+		fm.current = 0
+	}
+	fm.applyActive()
+}
+
+func (fm *FocusManager) applyActive() {
+	for i, c := range fm.order {
+		c.GetComponentData().IsActive = i == fm.current
+	}
+}
+
+func (fm *FocusManager) Current() Component {
+	if fm.current < 0 || fm.current >= len(fm.order) {
+		return nil
+	}
+	return fm.order[fm.current]
+}
+
+func (fm *FocusManager) Next() {
+	if len(fm.order) == 0 {
+		return
+	}
+	fm.current = (fm.current + 1) % len(fm.order)
+	fm.applyActive()
+}
+
+func (fm *FocusManager) Prev() {
+	if len(fm.order) == 0 {
+		return
+	}
+	fm.current = (fm.current - 1 + len(fm.order)) % len(fm.order)
+	fm.applyActive()
+}
+
+// keybinding is a single entry in the Keybindings registry: a key
+// string, an optional scope (nil means global), and the handler to run.
+// The handler's bool return follows Update's convention: true quits the
+// screen.
+type keybinding struct {
+	key     string
+	scope   Component
+	handler func() bool
+}
+
+// Keybindings is a registry of key handlers, checked before falling
+// through to component-local Update logic.
+type Keybindings struct {
+	bindings []keybinding
+}
+
+func (kb *Keybindings) bind(key string, scope Component, handler func() bool) {
+	kb.bindings = append(kb.bindings, keybinding{key: key, scope: scope, handler: handler})
+}
+
+// dispatch runs the first binding matching key whose scope is either
+// global (nil) or the currently focused component, and reports whether
+// a binding handled the key at all.
+func (kb *Keybindings) dispatch(key string, focused Component) (bool, bool) {
+	for _, b := range kb.bindings {
+		if b.key != key {
+			continue
+		}
+		if b.scope != nil && b.scope != focused {
+			continue
+		}
+		return b.handler(), true
+	}
+	return false, false
+}
+
+// Bind registers a keyboard shortcut. If scope is nil the binding is
+// global; otherwise it only fires while scope is the focused component.
+func (s *Screen) Bind(key string, scope Component, handler func() bool) {
+	s.keybindings.bind(key, scope, handler)
+}
+
+// Focus returns the screen's focus manager.
+func (s *Screen) Focus() *FocusManager {
+	return s.focus
+}
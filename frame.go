@@ -0,0 +1,108 @@
+package osui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/orus-dev/osui/colors"
+)
+
+type Attr int
+
+const (
+	AttrBold Attr = 1 << iota
+	AttrUnderline
+	AttrReverse
+)
+
+// Cell is a single terminal cell: the rune to draw plus the style it
+// should be drawn with. Two cells compare equal (==) exactly when they'd
+// paint the same thing, which is what the diff renderer relies on.
+type Cell struct {
+	Rune  rune
+	FG    string
+	BG    string
+	Attrs Attr
+}
+
+// Frame is a full-screen grid of cells, indexed [row][col].
+type Frame [][]Cell
+
+func NewFrame(width, height int) Frame {
+	frame := make(Frame, height)
+	for y := range frame {
+		frame[y] = make([]Cell, width)
+		for x := range frame[y] {
+			frame[y][x] = Cell{Rune: ' '}
+		}
+	}
+	return frame
+}
+
+func (f Frame) Size() (width, height int) {
+	if len(f) == 0 {
+		return 0, 0
+	}
+	return len(f[0]), len(f)
+}
+
+// cellStyle always starts from colors.Reset before applying the cell's
+// own FG/BG/attrs: SGR codes are cumulative, so without resetting first
+// an attribute like bold or reverse from a previous cell's style would
+// otherwise keep bleeding into cells that never asked for it.
+func cellStyle(c Cell) string {
+	style := colors.Reset + c.FG + c.BG
+	if c.Attrs&AttrBold != 0 {
+		style += "\x1b[1m"
+	}
+	if c.Attrs&AttrUnderline != 0 {
+		style += "\x1b[4m"
+	}
+	if c.Attrs&AttrReverse != 0 {
+		style += "\x1b[7m"
+	}
+	return style
+}
+
+// diffFrame walks prev and next cell-by-cell and emits only the escape
+// sequences needed to bring the terminal from prev to next: a cursor
+// move at the start of each run of changed cells, an SGR style change
+// only when the style differs from the last one written, and the
+// changed runes themselves. Adjacent changed cells on the same row are
+// coalesced into a single move+write instead of one move per cell.
+func diffFrame(prev, next Frame) string {
+	width, height := next.Size()
+	var b strings.Builder
+	lastStyle := ""
+	lastRow, lastCol := -1, -1
+
+	changed := func(y, x int) bool {
+		return y >= len(prev) || x >= len(prev[y]) || prev[y][x] != next[y][x]
+	}
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; {
+			if !changed(y, x) {
+				x++
+				continue
+			}
+			runStart := x
+			for x < width && changed(y, x) {
+				x++
+			}
+			if lastRow != y || lastCol != runStart {
+				fmt.Fprintf(&b, "\x1b[%d;%dH", y+1, runStart+1)
+			}
+			for i := runStart; i < x; i++ {
+				style := cellStyle(next[y][i])
+				if style != lastStyle {
+					b.WriteString(style)
+					lastStyle = style
+				}
+				b.WriteRune(next[y][i].Rune)
+			}
+			lastRow, lastCol = y, x
+		}
+	}
+	return b.String()
+}
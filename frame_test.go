@@ -0,0 +1,51 @@
+package osui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/orus-dev/osui/colors"
+)
+
+func TestCellStyleResetsBeforeApplyingStyle(t *testing.T) {
+	style := cellStyle(Cell{Rune: 'x', FG: colors.FG("red"), Attrs: AttrBold})
+	if !strings.HasPrefix(style, colors.Reset) {
+		t.Errorf("cellStyle = %q, want it to start with colors.Reset", style)
+	}
+	if !strings.Contains(style, colors.FG("red")) || !strings.Contains(style, "\x1b[1m") {
+		t.Errorf("cellStyle did not include both the FG color and the bold attribute: %q", style)
+	}
+}
+
+func TestDiffFrameOnlyEmitsChangedCells(t *testing.T) {
+	prev := Frame{
+		{{Rune: 'a'}, {Rune: 'b'}},
+		{{Rune: 'c'}, {Rune: 'd'}},
+	}
+	next := Frame{
+		{{Rune: 'a'}, {Rune: 'Z'}},
+		{{Rune: 'c'}, {Rune: 'd'}},
+	}
+
+	out := diffFrame(prev, next)
+
+	if !strings.Contains(out, "Z") {
+		t.Errorf("diffFrame output %q should contain the changed rune", out)
+	}
+	if strings.ContainsRune(out, 'a') || strings.ContainsRune(out, 'd') {
+		t.Errorf("diffFrame output %q should not redraw unchanged cells", out)
+	}
+	if !strings.Contains(out, "\x1b[1;2H") {
+		t.Errorf("diffFrame output %q should move the cursor to row 1, col 2 before the changed rune", out)
+	}
+}
+
+func TestDiffFrameOnFirstRenderRedrawsEverything(t *testing.T) {
+	next := Frame{{{Rune: 'a'}, {Rune: 'b'}}}
+
+	out := diffFrame(nil, next)
+
+	if !strings.ContainsRune(out, 'a') || !strings.ContainsRune(out, 'b') {
+		t.Errorf("diffFrame against a nil previous frame should redraw every cell, got %q", out)
+	}
+}
@@ -0,0 +1,50 @@
+package layout
+
+import "github.com/orus-dev/osui"
+
+type Center struct {
+	Component osui.Component
+
+	data osui.ComponentData
+}
+
+func NewCenter(c osui.Component) *Center {
+	return &Center{Component: c}
+}
+
+func (c *Center) GetComponentData() *osui.ComponentData {
+	return &c.data
+}
+
+func (c *Center) Children() []osui.Component {
+	return []osui.Component{c.Component}
+}
+
+func (c *Center) Layout(cs osui.Constraints) osui.Dimensions {
+	inner := measure(c.Component, Row, cs.MaxH, cs.MaxW)
+
+	// An unbounded axis has no space to center within, so Center reports
+	// its child's natural size along it instead of echoing the sentinel.
+	w, h := cs.MaxW, cs.MaxH
+	if isUnbounded(w) {
+		w = inner.W
+	}
+	if isUnbounded(h) {
+		h = inner.H
+	}
+
+	childData := c.Component.GetComponentData()
+	childData.X = (w - inner.W) / 2
+	childData.Y = (h - inner.H) / 2
+	childData.Width, childData.Height = inner.W, inner.H
+
+	return osui.Dimensions{W: w, H: h}
+}
+
+func (c *Center) Render() string {
+	return c.Component.Render()
+}
+
+func (c *Center) Update(e osui.Event) bool {
+	return c.Component.Update(e)
+}
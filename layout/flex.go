@@ -0,0 +1,162 @@
+package layout
+
+import "github.com/orus-dev/osui"
+
+type Direction int
+
+const (
+	Row Direction = iota
+	Column
+)
+
+// FlexChild pairs a component with how it should share the main axis.
+// Rigid children keep their measured size; the remaining space is split
+// between the other children in proportion to Weight.
+type FlexChild struct {
+	Component osui.Component
+	Weight    int
+	Rigid     bool
+}
+
+func Weighted(c osui.Component, weight int) FlexChild {
+	return FlexChild{Component: c, Weight: weight}
+}
+
+func Fixed(c osui.Component) FlexChild {
+	return FlexChild{Component: c, Rigid: true}
+}
+
+type Flex struct {
+	Direction Direction
+	Items     []FlexChild
+
+	data osui.ComponentData
+}
+
+func NewFlex(direction Direction, children ...FlexChild) *Flex {
+	return &Flex{Direction: direction, Items: children}
+}
+
+func (f *Flex) GetComponentData() *osui.ComponentData {
+	return &f.data
+}
+
+func (f *Flex) Children() []osui.Component {
+	children := make([]osui.Component, len(f.Items))
+	for i, item := range f.Items {
+		children[i] = item.Component
+	}
+	return children
+}
+
+func (f *Flex) Layout(c osui.Constraints) osui.Dimensions {
+	main, cross := c.MaxW, c.MaxH
+	if f.Direction == Column {
+		main, cross = c.MaxH, c.MaxW
+	}
+	unbounded := isUnbounded(main)
+
+	rigidSize := 0
+	totalWeight := 0
+	rigidDims := make([]osui.Dimensions, len(f.Items))
+	for i, child := range f.Items {
+		if !child.Rigid {
+			totalWeight += child.Weight
+			continue
+		}
+		// A Rigid child measures its own natural size, so it must not be
+		// told to fill (main=0 would collapse it) or share the space its
+		// siblings haven't claimed yet — pass Unbounded instead.
+		rigidDims[i] = measure(child.Component, f.Direction, cross, osui.Unbounded)
+		rigidSize += mainOf(f.Direction, rigidDims[i])
+	}
+
+	remaining := main - rigidSize
+	if unbounded || remaining < 0 {
+		remaining = 0
+	}
+
+	offset := 0
+	for i, child := range f.Items {
+		var dims osui.Dimensions
+		if child.Rigid {
+			dims = rigidDims[i]
+		} else {
+			share := 0
+			if totalWeight > 0 {
+				share = remaining * child.Weight / totalWeight
+			}
+			dims = measure(child.Component, f.Direction, cross, share)
+		}
+		place(child.Component, f.Direction, offset, cross, dims)
+		offset += mainOf(f.Direction, dims)
+	}
+
+	// When main itself is unbounded (this Flex is being measured for its
+	// own natural size, e.g. as somebody else's Rigid child), report the
+	// content it actually placed instead of echoing the sentinel back.
+	size := main
+	if unbounded {
+		size = offset
+	}
+	if f.Direction == Column {
+		return osui.Dimensions{W: cross, H: size}
+	}
+	return osui.Dimensions{W: size, H: cross}
+}
+
+func (f *Flex) Render() string {
+	var out string
+	for _, child := range f.Items {
+		out += child.Component.Render()
+	}
+	return out
+}
+
+func (f *Flex) Update(e osui.Event) bool {
+	for _, child := range f.Items {
+		if child.Component.Update(e) {
+			return true
+		}
+	}
+	return false
+}
+
+// isUnbounded reports whether n should be treated as osui.Unbounded. A
+// container that receives it (e.g. after Padding subtracts its own
+// insets from Unbounded) must report its natural content size instead
+// of filling to n, so a small tolerance below the sentinel still counts.
+func isUnbounded(n int) bool {
+	return n > osui.Unbounded/2
+}
+
+func mainOf(dir Direction, d osui.Dimensions) int {
+	if dir == Column {
+		return d.H
+	}
+	return d.W
+}
+
+func measure(c osui.Component, dir Direction, cross, main int) osui.Dimensions {
+	var constraints osui.Constraints
+	if dir == Column {
+		constraints = osui.Constraints{MaxW: cross, MaxH: main}
+	} else {
+		constraints = osui.Constraints{MaxW: main, MaxH: cross}
+	}
+	if lc, ok := c.(osui.Layoutable); ok {
+		return lc.Layout(constraints)
+	}
+	data := c.GetComponentData()
+	return osui.Dimensions{W: data.Width, H: data.Height}
+}
+
+func place(c osui.Component, dir Direction, offset, cross int, dims osui.Dimensions) {
+	data := c.GetComponentData()
+	if dir == Column {
+		data.X, data.Y = 0, offset
+	} else {
+		data.X, data.Y = offset, 0
+	}
+	data.Width, data.Height = dims.W, dims.H
+}
@@ -0,0 +1,40 @@
+package layout
+
+import (
+	"testing"
+
+	"github.com/orus-dev/osui"
+)
+
+// fixedLeaf is a non-Layoutable component with a hardcoded size, the
+// simplest possible Component to place inside a Flex/Center/Stack.
+type fixedLeaf struct {
+	data osui.ComponentData
+}
+
+func newFixedLeaf(w, h int) *fixedLeaf {
+	return &fixedLeaf{data: osui.ComponentData{Width: w, Height: h}}
+}
+
+func (l *fixedLeaf) GetComponentData() *osui.ComponentData { return &l.data }
+func (l *fixedLeaf) Render() string                        { return "" }
+func (l *fixedLeaf) Update(osui.Event) bool                { return false }
+
+// TestFlexRigidNestedFlexKeepsNaturalSize reproduces the reported bug:
+// a Flex nested as a Rigid/Fixed child used to be measured with
+// main=0, which Flex.Layout echoed straight back as its own width,
+// collapsing it to zero instead of reporting its content's real size.
+func TestFlexRigidNestedFlexKeepsNaturalSize(t *testing.T) {
+	leaf := newFixedLeaf(10, 1)
+	inner := NewFlex(Row, Weighted(leaf, 1))
+	outer := NewFlex(Row, Fixed(inner), Weighted(newFixedLeaf(0, 1), 1))
+
+	outer.Layout(osui.Constraints{MaxW: 40, MaxH: 1})
+
+	if inner.data.Width != 10 {
+		t.Errorf("inner Flex width = %d, want 10 (leaf's natural width, not collapsed to 0)", inner.data.Width)
+	}
+	if leaf.data.Width != 10 {
+		t.Errorf("leaf width = %d, want 10", leaf.data.Width)
+	}
+}
@@ -0,0 +1,50 @@
+package layout
+
+import "github.com/orus-dev/osui"
+
+type Padding struct {
+	Top, Right, Bottom, Left int
+	Component                osui.Component
+
+	data osui.ComponentData
+}
+
+func NewPadding(top, right, bottom, left int, c osui.Component) *Padding {
+	return &Padding{Top: top, Right: right, Bottom: bottom, Left: left, Component: c}
+}
+
+func (p *Padding) GetComponentData() *osui.ComponentData {
+	return &p.data
+}
+
+func (p *Padding) Children() []osui.Component {
+	return []osui.Component{p.Component}
+}
+
+func (p *Padding) Layout(cs osui.Constraints) osui.Dimensions {
+	innerW := cs.MaxW - p.Left - p.Right
+	innerH := cs.MaxH - p.Top - p.Bottom
+	if innerW < 0 {
+		innerW = 0
+	}
+	if innerH < 0 {
+		innerH = 0
+	}
+
+	inner := measure(p.Component, Row, innerH, innerW)
+
+	childData := p.Component.GetComponentData()
+	childData.X = p.Left
+	childData.Y = p.Top
+	childData.Width, childData.Height = inner.W, inner.H
+
+	return osui.Dimensions{W: inner.W + p.Left + p.Right, H: inner.H + p.Top + p.Bottom}
+}
+
+func (p *Padding) Render() string {
+	return p.Component.Render()
+}
+
+func (p *Padding) Update(e osui.Event) bool {
+	return p.Component.Update(e)
+}
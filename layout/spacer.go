@@ -0,0 +1,48 @@
+package layout
+
+import "github.com/orus-dev/osui"
+
+// Spacer renders nothing; it exists to soak up leftover space in a Flex
+// via Weighted, or to reserve a fixed gap via Fixed after setting its
+// ComponentData.Width/Height before its first Layout.
+type Spacer struct {
+	data osui.ComponentData
+
+	// fixedW/fixedH hold the size set on ComponentData before the first
+	// Layout; the container placing the Spacer overwrites
+	// ComponentData.Width/Height with the placed size afterwards, so that
+	// size must not be read back as a fixed one on the next Layout.
+	fixedW, fixedH int
+	sized          bool
+}
+
+func NewSpacer() *Spacer {
+	return &Spacer{}
+}
+
+func (s *Spacer) GetComponentData() *osui.ComponentData {
+	return &s.data
+}
+
+func (s *Spacer) Layout(cs osui.Constraints) osui.Dimensions {
+	if !s.sized {
+		s.fixedW, s.fixedH = s.data.Width, s.data.Height
+		s.sized = true
+	}
+	w, h := s.fixedW, s.fixedH
+	if w == 0 {
+		w = cs.MaxW
+	}
+	if h == 0 {
+		h = cs.MaxH
+	}
+	return osui.Dimensions{W: w, H: h}
+}
+
+func (s *Spacer) Render() string {
+	return ""
+}
+
+func (s *Spacer) Update(osui.Event) bool {
+	return false
+}
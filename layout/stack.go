@@ -0,0 +1,70 @@
+package layout
+
+import "github.com/orus-dev/osui"
+
+// Stack overlays its children in z-order, back to front (later children
+// are painted on top and get events first), each measured against the
+// full available constraints.
+type Stack struct {
+	Items []osui.Component
+
+	data osui.ComponentData
+}
+
+func NewStack(children ...osui.Component) *Stack {
+	return &Stack{Items: children}
+}
+
+func (s *Stack) GetComponentData() *osui.ComponentData {
+	return &s.data
+}
+
+func (s *Stack) Children() []osui.Component {
+	children := make([]osui.Component, len(s.Items))
+	copy(children, s.Items)
+	return children
+}
+
+func (s *Stack) Layout(cs osui.Constraints) osui.Dimensions {
+	maxW, maxH := 0, 0
+	for _, child := range s.Items {
+		dims := measure(child, Row, cs.MaxH, cs.MaxW)
+		data := child.GetComponentData()
+		data.X, data.Y = 0, 0
+		data.Width, data.Height = dims.W, dims.H
+		if dims.W > maxW {
+			maxW = dims.W
+		}
+		if dims.H > maxH {
+			maxH = dims.H
+		}
+	}
+
+	// An unbounded axis has nothing to fill, so Stack reports the
+	// largest child it measured along it instead of the sentinel.
+	w, h := cs.MaxW, cs.MaxH
+	if isUnbounded(w) {
+		w = maxW
+	}
+	if isUnbounded(h) {
+		h = maxH
+	}
+	return osui.Dimensions{W: w, H: h}
+}
+
+func (s *Stack) Render() string {
+	var out string
+	for _, child := range s.Items {
+		out += child.Render()
+	}
+	return out
+}
+
+func (s *Stack) Update(e osui.Event) bool {
+	for i := len(s.Items) - 1; i >= 0; i-- {
+		if s.Items[i].Update(e) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package osui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// EnableMouse turns on xterm mouse tracking: basic button events (1000),
+// button-motion/drag events (1002), and SGR extended coordinates (1006)
+// so clicks past column/row 223 still decode correctly.
+func EnableMouse() {
+	fmt.Print("\x1b[?1000h\x1b[?1002h\x1b[?1006h")
+}
+
+func DisableMouse() {
+	fmt.Print("\x1b[?1006l\x1b[?1002l\x1b[?1000l")
+}
+
+// ReadEvent reads the next input, decoding an SGR mouse report into a
+// MouseEvent and everything else into a KeyEvent.
+func ReadEvent() (Event, error) {
+	raw, err := ReadKey()
+	if err != nil {
+		return nil, err
+	}
+	if m, ok := parseMouseEvent(raw); ok {
+		return m, nil
+	}
+	return KeyEvent{Key: raw}, nil
+}
+
+// parseMouseEvent decodes an SGR mouse sequence of the form
+// "\x1b[<b;x;y;M" (press/drag) or "...;m" (release).
+func parseMouseEvent(raw string) (MouseEvent, bool) {
+	if !strings.HasPrefix(raw, "\x1b[<") {
+		return MouseEvent{}, false
+	}
+	body := raw[3:]
+	if len(body) == 0 {
+		return MouseEvent{}, false
+	}
+
+	final := body[len(body)-1]
+	if final != 'M' && final != 'm' {
+		return MouseEvent{}, false
+	}
+	body = body[:len(body)-1]
+
+	parts := strings.Split(body, ";")
+	if len(parts) != 3 {
+		return MouseEvent{}, false
+	}
+	b, err1 := strconv.Atoi(parts[0])
+	x, err2 := strconv.Atoi(parts[1])
+	y, err3 := strconv.Atoi(parts[2])
+	if err1 != nil || err2 != nil || err3 != nil {
+		return MouseEvent{}, false
+	}
+
+	event := MouseEvent{X: x - 1, Y: y - 1}
+	event.Mod = decodeMod(b)
+
+	switch {
+	case b&64 != 0:
+		event.Action = MouseWheel
+		if b&1 != 0 {
+			event.Button = MouseWheelDown
+		} else {
+			event.Button = MouseWheelUp
+		}
+	case b&32 != 0:
+		event.Action = MouseDrag
+		event.Button = decodeButton(b)
+	case final == 'm':
+		event.Action = MouseRelease
+		event.Button = decodeButton(b)
+	default:
+		event.Action = MousePress
+		event.Button = decodeButton(b)
+	}
+
+	return event, true
+}
+
+// decodeButton maps the low two bits of an SGR mouse report's button
+// code to a MouseButton. 3 is the standard "no button" code (reported
+// on drags and releases with nothing held) — it must not fall through
+// to MouseButton(3), which is MouseWheelUp in the button enum.
+func decodeButton(b int) MouseButton {
+	switch b & 3 {
+	case 0:
+		return MouseLeft
+	case 1:
+		return MouseMiddle
+	case 2:
+		return MouseRight
+	default:
+		return MouseNone
+	}
+}
+
+func decodeMod(b int) string {
+	var mods []string
+	if b&4 != 0 {
+		mods = append(mods, "shift")
+	}
+	if b&8 != 0 {
+		mods = append(mods, "alt")
+	}
+	if b&16 != 0 {
+		mods = append(mods, "ctrl")
+	}
+	return strings.Join(mods, "+")
+}
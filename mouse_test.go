@@ -0,0 +1,21 @@
+package osui
+
+import "testing"
+
+func TestDecodeButtonNoButtonIsNotWheelUp(t *testing.T) {
+	if got := decodeButton(3); got != MouseNone {
+		t.Errorf("decodeButton(3) = %v, want MouseNone", got)
+	}
+	if got := decodeButton(35); got != MouseNone {
+		t.Errorf("decodeButton(35) = %v, want MouseNone (motion bit set, button bits still 3)", got)
+	}
+}
+
+func TestDecodeButtonOrdinaryButtons(t *testing.T) {
+	cases := map[int]MouseButton{0: MouseLeft, 1: MouseMiddle, 2: MouseRight}
+	for b, want := range cases {
+		if got := decodeButton(b); got != want {
+			t.Errorf("decodeButton(%d) = %v, want %v", b, got, want)
+		}
+	}
+}
@@ -3,7 +3,9 @@ package osui
 import (
 	"fmt"
 	"os"
-	"strings"
+	"os/signal"
+	"sync"
+	"syscall"
 
 	"github.com/orus-dev/osui/colors"
 	"golang.org/x/term"
@@ -22,35 +24,241 @@ type ComponentData struct {
 type Component interface {
 	Render() string
 	GetComponentData() *ComponentData
-	Update(string) bool
+	Update(Event) bool
+}
+
+// Container is implemented by components that place other components,
+// so the screen can walk the layout tree for mouse hit-testing and
+// focus collection without every leaf needing to know about it.
+type Container interface {
+	Children() []Component
+}
+
+// Event is the sum type delivered to Component.Update: a KeyEvent,
+// MouseEvent, or ResizeEvent.
+type Event interface {
+	isEvent()
+}
+
+type KeyEvent struct {
+	Key string
+}
+
+func (KeyEvent) isEvent() {}
+
+type MouseButton int
+
+const (
+	MouseLeft MouseButton = iota
+	MouseMiddle
+	MouseRight
+	MouseWheelUp
+	MouseWheelDown
+	MouseNone
+)
+
+type MouseAction int
+
+const (
+	MousePress MouseAction = iota
+	MouseRelease
+	MouseDrag
+	MouseWheel
+)
+
+type MouseEvent struct {
+	Button MouseButton
+	X, Y   int
+	Mod    string
+	Action MouseAction
+}
+
+func (MouseEvent) isEvent() {}
+
+type ResizeEvent struct {
+	Width, Height int
+}
+
+func (ResizeEvent) isEvent() {}
+
+// Constraints bounds the space a component may occupy during the measure
+// pass, mirroring the box-constraint model used by Gio and Flutter.
+type Constraints struct {
+	MaxW, MaxH int
+}
+
+// Unbounded is a MaxW/MaxH sentinel meaning "no limit — report your
+// natural size" instead of "fill up to this bound". Containers use it
+// to measure a child's intrinsic size, e.g. Flex measuring a Rigid
+// child along the main axis, where the child shouldn't be told to fill
+// (or collapse into) the space its siblings haven't claimed yet.
+const Unbounded = 1 << 30
+
+// Dimensions is the size a component chose after being measured against
+// a Constraints.
+type Dimensions struct {
+	W, H int
+}
+
+// Layoutable is implemented by components that want to take part in the
+// two-pass measure/place layout walk instead of relying on a fixed
+// ComponentData.Width/Height. Layout must set the ComponentData of any
+// children it places, and return the size it chose for itself. Because
+// Layout runs bottom-up before a container knows its own final origin,
+// the X/Y it assigns children are relative to its own top-left corner;
+// resolvePositions makes a single top-down pass afterwards to turn
+// those into screen-absolute coordinates.
+type Layoutable interface {
+	Layout(Constraints) Dimensions
 }
 
 type Screen struct {
-	component Component
+	component   Component
+	renderMu    sync.Mutex
+	theme       *colors.Theme
+	focus       *FocusManager
+	keybindings Keybindings
+	prevFrame   Frame
+}
+
+func (s *Screen) SetTheme(t *colors.Theme) {
+	s.theme = t
+}
+
+func (s *Screen) Theme() *colors.Theme {
+	return s.theme
 }
 
 func NewScreen(c Component) *Screen {
 	HideCursor()
-	s := &Screen{component: c}
+	s := &Screen{component: c, focus: newFocusManager()}
 	return s
 }
 
 func (s *Screen) Render() {
+	s.renderMu.Lock()
+	defer s.renderMu.Unlock()
+
 	width, height := GetTerminalSize()
 	frame := NewFrame(width, height)
 	data := s.component.GetComponentData()
-	if data.Height == 0 {
-		data.Height = height
-	}
-	if data.Width == 0 {
-		data.Width = width
-	}
 	data.Screen = s
 	data.IsActive = true
 	data.DefaultColor = colors.Reset
+
+	if lc, ok := s.component.(Layoutable); ok {
+		dims := lc.Layout(Constraints{MaxW: width, MaxH: height})
+		data.X, data.Y = 0, 0
+		data.Width, data.Height = dims.W, dims.H
+		resolvePositions(s, s.component)
+	} else {
+		if data.Height == 0 {
+			data.Height = height
+		}
+		if data.Width == 0 {
+			data.Width = width
+		}
+	}
+
+	s.focus.collect(s.component)
+
 	RenderOnFrame(s.component, &frame)
-	Clear()
-	fmt.Print(strings.Join(frame, ""))
+
+	prevWidth, prevHeight := s.prevFrame.Size()
+	if prevHeight == 0 || prevWidth != width || prevHeight != height {
+		Clear()
+		s.prevFrame = nil
+	}
+	fmt.Print(diffFrame(s.prevFrame, frame))
+	s.prevFrame = frame
+}
+
+func (s *Screen) watchResize() {
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGWINCH)
+	for range sig {
+		width, height := GetTerminalSize()
+		s.component.Update(ResizeEvent{Width: width, Height: height})
+		s.Render()
+	}
+}
+
+// dispatch routes an event to the component that should handle it. Key
+// events first pass through the keybindings registry, then the built-in
+// Tab/Shift-Tab focus cycling, before reaching the focused component (or
+// the root, if nothing is focused). Mouse events are hit-tested against
+// the placed rects from the layout pass and delivered only to the
+// deepest component under the cursor.
+func (s *Screen) dispatch(e Event) bool {
+	switch ev := e.(type) {
+	case MouseEvent:
+		target := hitTest(s.component, ev.X, ev.Y)
+		return target.Update(e)
+	case KeyEvent:
+		if quit, handled := s.keybindings.dispatch(ev.Key, s.focus.Current()); handled {
+			return quit
+		}
+		switch ev.Key {
+		case "tab":
+			s.focus.Next()
+			return false
+		case "shift+tab":
+			s.focus.Prev()
+			return false
+		}
+		if target := s.focus.Current(); target != nil {
+			return target.Update(e)
+		}
+		return s.component.Update(e)
+	default:
+		return s.component.Update(e)
+	}
+}
+
+// resolvePositions turns the container-local coordinates each
+// Layoutable.Layout assigns its children (relative to that container's
+// own, not-yet-known origin) into screen-absolute ones. It walks the
+// tree top-down from the root, whose origin is always (0, 0), adding
+// each container's own already-resolved X/Y to every child it places
+// before descending into that child's subtree. It also stamps every
+// descendant's ComponentData.Screen, since only the root gets one
+// otherwise — without it, a widget nested in a layout container has no
+// way to reach Screen.Theme().
+func resolvePositions(s *Screen, c Component) {
+	container, ok := c.(Container)
+	if !ok {
+		return
+	}
+	origin := c.GetComponentData()
+	for _, child := range container.Children() {
+		data := child.GetComponentData()
+		data.X += origin.X
+		data.Y += origin.Y
+		data.Screen = s
+		resolvePositions(s, child)
+	}
+}
+
+// hitTest walks a container's children back-to-front (last child
+// first), matching the paint order (later children are drawn on top)
+// and the dispatch order Stack.Update already uses for keys. This
+// matters for overlapping rects, e.g. layout.Stack, whose children all
+// occupy the same full-size rect: a click must land on the top-most
+// overlay, not the first one that happens to match.
+func hitTest(c Component, x, y int) Component {
+	container, ok := c.(Container)
+	if !ok {
+		return c
+	}
+	children := container.Children()
+	for i := len(children) - 1; i >= 0; i-- {
+		child := children[i]
+		data := child.GetComponentData()
+		if x >= data.X && x < data.X+data.Width && y >= data.Y && y < data.Y+data.Height {
+			return hitTest(child, x, y)
+		}
+	}
+	return c
 }
 
 func (s *Screen) Run() {
@@ -61,10 +269,16 @@ func (s *Screen) Run() {
 	defer term.Restore(int(os.Stdin.Fd()), oldState)
 	data := s.component.GetComponentData()
 	data.Screen = s
+	go s.watchResize()
+	EnableMouse()
+	defer DisableMouse()
 	for {
 		s.Render()
-		k, _ := ReadKey()
-		if s.component.Update(k) {
+		e, err := ReadEvent()
+		if err != nil {
+			continue
+		}
+		if s.dispatch(e) {
 			ShowCursor()
 			return
 		}
@@ -0,0 +1,59 @@
+package osui
+
+import "testing"
+
+type fakeComponent struct {
+	data     ComponentData
+	children []*fakeComponent
+}
+
+func (f *fakeComponent) GetComponentData() *ComponentData { return &f.data }
+func (f *fakeComponent) Render() string                   { return "" }
+func (f *fakeComponent) Update(Event) bool                { return false }
+
+func (f *fakeComponent) Children() []Component {
+	children := make([]Component, len(f.children))
+	for i, c := range f.children {
+		children[i] = c
+	}
+	return children
+}
+
+// TestResolvePositionsNestedContainers reproduces the two-level nesting
+// case from the layout request: a Flex-like row placing a Padding-like
+// box at a non-zero offset, itself padding a leaf at a local offset.
+// Both containers only know the leaf's position relative to their own
+// origin when they place it; resolvePositions must turn those into
+// screen-absolute coordinates.
+func TestResolvePositionsNestedContainers(t *testing.T) {
+	leaf := &fakeComponent{data: ComponentData{X: 1, Y: 0}}
+	padding := &fakeComponent{data: ComponentData{X: 12, Y: 0}, children: []*fakeComponent{leaf}}
+	row := &fakeComponent{data: ComponentData{X: 0, Y: 0}, children: []*fakeComponent{padding}}
+
+	screen := &Screen{}
+	resolvePositions(screen, row)
+
+	if padding.data.X != 12 {
+		t.Errorf("padding.X = %d, want 12", padding.data.X)
+	}
+	if leaf.data.X != 13 {
+		t.Errorf("leaf.X = %d, want 13 (padding's 12 + its own local offset of 1)", leaf.data.X)
+	}
+	if padding.data.Screen != screen || leaf.data.Screen != screen {
+		t.Error("resolvePositions should stamp Screen onto every descendant, not just the root")
+	}
+}
+
+// TestHitTestPrefersTopmostOverlappingChild reproduces the Stack mouse
+// dispatch bug: overlapping children (same rect) must hit-test to the
+// last one in Children(), matching the paint order (later = on top)
+// that Stack.Update already dispatches keys with.
+func TestHitTestPrefersTopmostOverlappingChild(t *testing.T) {
+	bottom := &fakeComponent{data: ComponentData{Width: 10, Height: 10}}
+	top := &fakeComponent{data: ComponentData{Width: 10, Height: 10}}
+	stack := &fakeComponent{children: []*fakeComponent{bottom, top}}
+
+	if got := hitTest(stack, 5, 5); got != Component(top) {
+		t.Errorf("hitTest returned %v, want the last (topmost) overlapping child", got)
+	}
+}
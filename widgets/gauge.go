@@ -0,0 +1,66 @@
+package widgets
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/orus-dev/osui"
+	"github.com/orus-dev/osui/colors"
+)
+
+// ColorThreshold maps a percentage floor to the color used once the
+// gauge's value reaches or exceeds it. Thresholds are checked from the
+// last entry to the first and the first one reached wins, so the highest
+// floor reached is used only when the entries are in ascending Min order.
+type ColorThreshold struct {
+	Min int
+	FG  string
+}
+
+type Gauge struct {
+	Percent    int
+	Label      string
+	FillRune   rune
+	EmptyRune  rune
+	Thresholds []ColorThreshold
+
+	data osui.ComponentData
+}
+
+func NewGauge(label string) *Gauge {
+	return &Gauge{Label: label, FillRune: '█', EmptyRune: '░'}
+}
+
+func (g *Gauge) GetComponentData() *osui.ComponentData {
+	return &g.data
+}
+
+func (g *Gauge) color() string {
+	for i := len(g.Thresholds) - 1; i >= 0; i-- {
+		if g.Percent >= g.Thresholds[i].Min {
+			return g.Thresholds[i].FG
+		}
+	}
+	return ""
+}
+
+func (g *Gauge) Render() string {
+	width := g.data.Width
+	if width <= 0 {
+		width = 20
+	}
+
+	filled := width * g.Percent / 100
+	if filled > width {
+		filled = width
+	}
+
+	bar := strings.Repeat(string(g.FillRune), filled) + strings.Repeat(string(g.EmptyRune), width-filled)
+	label := fmt.Sprintf("%s %3d%%", g.Label, g.Percent)
+
+	return colors.FG(g.color()) + bar + colors.Reset + " " + label
+}
+
+func (g *Gauge) Update(osui.Event) bool {
+	return false
+}
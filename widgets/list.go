@@ -0,0 +1,101 @@
+package widgets
+
+import (
+	"strings"
+
+	"github.com/orus-dev/osui"
+	"github.com/orus-dev/osui/colors"
+)
+
+type List struct {
+	Items      []string
+	Selected   int
+	SelectedFG string
+	SelectedBG string
+
+	data osui.ComponentData
+}
+
+func NewList(items ...string) *List {
+	return &List{Items: items, SelectedBG: "white", SelectedFG: "black"}
+}
+
+func (l *List) GetComponentData() *osui.ComponentData {
+	return &l.data
+}
+
+// Focusable opts List into the screen's Tab/Shift-Tab focus cycle, since
+// its Up/Down handling only makes sense while it holds keyboard focus.
+func (l *List) Focusable() bool {
+	return true
+}
+
+func (l *List) Next() {
+	if len(l.Items) == 0 {
+		return
+	}
+	l.Selected = (l.Selected + 1) % len(l.Items)
+}
+
+func (l *List) Prev() {
+	if len(l.Items) == 0 {
+		return
+	}
+	l.Selected = (l.Selected - 1 + len(l.Items)) % len(l.Items)
+}
+
+func (l *List) visibleRange() (int, int) {
+	height := l.data.Height
+	if height <= 0 || height >= len(l.Items) {
+		return 0, len(l.Items)
+	}
+	start := l.Selected - height/2
+	if start < 0 {
+		start = 0
+	}
+	end := start + height
+	if end > len(l.Items) {
+		end = len(l.Items)
+		start = end - height
+	}
+	return start, end
+}
+
+// selectionStyle prefers the screen's active Theme's RoleSelection
+// colors, falling back to the widget's own SelectedFG/SelectedBG when
+// no theme is set, so List still looks right standalone.
+func (l *List) selectionStyle() string {
+	if l.data.Screen != nil {
+		if theme := l.data.Screen.Theme(); theme != nil {
+			return theme.FG(colors.RoleSelection) + theme.BG(colors.RoleSelection)
+		}
+	}
+	return colors.FG(l.SelectedFG) + colors.BG(l.SelectedBG)
+}
+
+func (l *List) Render() string {
+	start, end := l.visibleRange()
+	lines := make([]string, 0, end-start)
+	for i := start; i < end; i++ {
+		item := l.Items[i]
+		if i == l.Selected {
+			item = l.selectionStyle() + item + colors.Reset
+		}
+		lines = append(lines, item)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (l *List) Update(e osui.Event) bool {
+	key, ok := e.(osui.KeyEvent)
+	if !ok {
+		return false
+	}
+	switch key.Key {
+	case "up":
+		l.Prev()
+	case "down":
+		l.Next()
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+package widgets
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/orus-dev/osui"
+	"github.com/orus-dev/osui/colors"
+)
+
+// newTestTheme builds a one-role Theme via colors.LoadTheme, the only
+// way to construct one from outside the colors package.
+func newTestTheme(t *testing.T, role colors.Role, spec string) *colors.Theme {
+	t.Helper()
+	raw, err := json.Marshal(map[string]string{string(role): spec})
+	if err != nil {
+		t.Fatalf("marshaling test theme: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "theme.json")
+	if err := os.WriteFile(path, raw, 0o644); err != nil {
+		t.Fatalf("writing test theme: %v", err)
+	}
+	theme, err := colors.LoadTheme(path)
+	if err != nil {
+		t.Fatalf("LoadTheme: %v", err)
+	}
+	return theme
+}
+
+func TestVisibleRangeShortListShowsEverything(t *testing.T) {
+	l := NewList("a", "b", "c")
+	l.data.Height = 10
+
+	start, end := l.visibleRange()
+
+	if start != 0 || end != 3 {
+		t.Errorf("visibleRange() = %d,%d, want 0,3 when the list fits inside the height", start, end)
+	}
+}
+
+func TestVisibleRangeCentersOnSelection(t *testing.T) {
+	l := NewList("a", "b", "c", "d", "e", "f", "g", "h", "i", "j")
+	l.data.Height = 4
+	l.Selected = 8
+
+	start, end := l.visibleRange()
+
+	if start != 6 {
+		t.Errorf("visibleRange() start = %d, want 6 (selection 8 minus half the height)", start)
+	}
+	if end != len(l.Items) {
+		t.Errorf("visibleRange() end = %d, want %d (clamped to the last item)", end, len(l.Items))
+	}
+	if end-start != l.data.Height {
+		t.Errorf("visibleRange() window = %d, want height %d", end-start, l.data.Height)
+	}
+}
+
+func TestSelectionStyleFallsBackWithoutTheme(t *testing.T) {
+	l := NewList("a")
+	l.SelectedFG, l.SelectedBG = "red", "blue"
+
+	want := colors.FG("red") + colors.BG("blue")
+	if got := l.selectionStyle(); got != want {
+		t.Errorf("selectionStyle() = %q, want %q (no Screen/Theme set)", got, want)
+	}
+}
+
+func TestSelectionStylePrefersTheme(t *testing.T) {
+	l := NewList("a")
+	l.SelectedFG, l.SelectedBG = "red", "blue"
+
+	screen := &osui.Screen{}
+	screen.SetTheme(newTestTheme(t, colors.RoleSelection, "green"))
+	l.data.Screen = screen
+
+	want := colors.FG("green") + colors.BG("green")
+	if got := l.selectionStyle(); got != want {
+		t.Errorf("selectionStyle() = %q, want %q (Screen's Theme takes priority)", got, want)
+	}
+}
+
+func TestNextAndPrevWrapAround(t *testing.T) {
+	l := NewList("a", "b", "c")
+
+	l.Prev()
+	if l.Selected != 2 {
+		t.Errorf("Prev() from 0 = %d, want 2 (wrap to the last item)", l.Selected)
+	}
+
+	l.Next()
+	if l.Selected != 0 {
+		t.Errorf("Next() from 2 = %d, want 0 (wrap to the first item)", l.Selected)
+	}
+}
@@ -0,0 +1,80 @@
+package widgets
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/orus-dev/osui"
+)
+
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+type Sparkline struct {
+	Title      string
+	Data       []float64
+	ShowValue  bool
+	MaxSamples int
+
+	data osui.ComponentData
+}
+
+func NewSparkline(title string) *Sparkline {
+	return &Sparkline{Title: title, MaxSamples: 0}
+}
+
+func (s *Sparkline) Push(v float64) {
+	s.Data = append(s.Data, v)
+	if s.MaxSamples > 0 && len(s.Data) > s.MaxSamples {
+		s.Data = s.Data[len(s.Data)-s.MaxSamples:]
+	}
+}
+
+func (s *Sparkline) GetComponentData() *osui.ComponentData {
+	return &s.data
+}
+
+func (s *Sparkline) samples() []float64 {
+	width := s.data.Width
+	if width <= 0 || width >= len(s.Data) {
+		return s.Data
+	}
+	return s.Data[len(s.Data)-width:]
+}
+
+func (s *Sparkline) Render() string {
+	samples := s.samples()
+	if len(samples) == 0 {
+		return s.Title
+	}
+
+	min, max := samples[0], samples[0]
+	for _, v := range samples {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+	}
+
+	var b strings.Builder
+	if s.Title != "" {
+		b.WriteString(s.Title + " ")
+	}
+	spread := max - min
+	for _, v := range samples {
+		level := 0
+		if spread > 0 {
+			level = int((v - min) / spread * float64(len(sparkBlocks)-1))
+		}
+		b.WriteRune(sparkBlocks[level])
+	}
+	if s.ShowValue {
+		b.WriteString(fmt.Sprintf(" %.2f", samples[len(samples)-1]))
+	}
+	return b.String()
+}
+
+func (s *Sparkline) Update(osui.Event) bool {
+	return false
+}
@@ -0,0 +1,43 @@
+package widgets
+
+import "testing"
+
+func TestSamplesReturnsEverythingWhenWiderThanData(t *testing.T) {
+	s := NewSparkline("cpu")
+	s.Data = []float64{1, 2, 3}
+	s.data.Width = 10
+
+	got := s.samples()
+
+	if len(got) != 3 {
+		t.Errorf("samples() = %v, want all 3 points when width exceeds data length", got)
+	}
+}
+
+func TestSamplesTrimsToWidth(t *testing.T) {
+	s := NewSparkline("cpu")
+	s.Data = []float64{1, 2, 3, 4, 5}
+	s.data.Width = 2
+
+	got := s.samples()
+
+	if len(got) != 2 || got[0] != 4 || got[1] != 5 {
+		t.Errorf("samples() = %v, want the last 2 points [4 5]", got)
+	}
+}
+
+func TestPushTrimsToMaxSamples(t *testing.T) {
+	s := NewSparkline("cpu")
+	s.MaxSamples = 3
+
+	for _, v := range []float64{1, 2, 3, 4} {
+		s.Push(v)
+	}
+
+	if len(s.Data) != 3 {
+		t.Fatalf("len(Data) = %d, want 3", len(s.Data))
+	}
+	if s.Data[0] != 2 {
+		t.Errorf("Data[0] = %v, want 2 (oldest sample dropped)", s.Data[0])
+	}
+}
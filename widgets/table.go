@@ -0,0 +1,111 @@
+package widgets
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/orus-dev/osui"
+	"github.com/orus-dev/osui/colors"
+)
+
+type TableCell struct {
+	Text  string
+	FG    string
+	BG    string
+	Align Align
+}
+
+type TableRow []TableCell
+
+type Table struct {
+	Header    TableRow
+	Rows      []TableRow
+	Separator bool
+
+	data osui.ComponentData
+}
+
+func NewTable(header TableRow, rows ...TableRow) *Table {
+	return &Table{Header: header, Rows: rows}
+}
+
+func (t *Table) GetComponentData() *osui.ComponentData {
+	return &t.data
+}
+
+func (t *Table) columnWidths() []int {
+	cols := len(t.Header)
+	widths := make([]int, cols)
+	for i, cell := range t.Header {
+		widths[i] = utf8.RuneCountInString(cell.Text)
+	}
+	for _, row := range t.Rows {
+		for i, cell := range row {
+			if i < cols {
+				if w := utf8.RuneCountInString(cell.Text); w > widths[i] {
+					widths[i] = w
+				}
+			}
+		}
+	}
+	return widths
+}
+
+func (t *Table) renderRow(row TableRow, widths []int) string {
+	cells := make([]string, len(row))
+	for i, cell := range row {
+		if i >= len(widths) {
+			break
+		}
+		padded := align(cell.Text, widths[i], cell.Align)
+		cells[i] = colors.FG(cell.FG) + colors.BG(cell.BG) + padded + colors.Reset
+	}
+	return strings.Join(cells, " │ ")
+}
+
+func (t *Table) Render() string {
+	widths := t.columnWidths()
+	var b strings.Builder
+	b.WriteString(t.renderRow(t.Header, widths))
+	if t.Separator {
+		total := 0
+		for _, w := range widths {
+			total += w + 3
+		}
+		b.WriteString("\n" + strings.Repeat("─", total))
+	}
+	for _, row := range t.Rows {
+		b.WriteString("\n" + t.renderRow(row, widths))
+	}
+	return b.String()
+}
+
+func (t *Table) Update(osui.Event) bool {
+	return false
+}
+
+type Align int
+
+const (
+	AlignLeft Align = iota
+	AlignRight
+	AlignCenter
+)
+
+func align(s string, width int, a Align) string {
+	length := utf8.RuneCountInString(s)
+	if length >= width {
+		return s
+	}
+	pad := width - length
+	switch a {
+	case AlignRight:
+		return strings.Repeat(" ", pad) + s
+	case AlignCenter:
+		left := pad / 2
+		right := pad - left
+		return strings.Repeat(" ", left) + s + strings.Repeat(" ", right)
+	default:
+		return s + strings.Repeat(" ", pad)
+	}
+}
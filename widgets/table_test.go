@@ -0,0 +1,31 @@
+package widgets
+
+import "testing"
+
+func TestColumnWidthsCountsRunesNotBytes(t *testing.T) {
+	table := NewTable(
+		TableRow{{Text: "Name"}, {Text: "City"}},
+		TableRow{{Text: "José"}, {Text: "東京"}},
+	)
+
+	widths := table.columnWidths()
+
+	if widths[0] != 4 {
+		t.Errorf("widths[0] = %d, want 4 (\"José\" is 4 runes, 5 bytes)", widths[0])
+	}
+	if widths[1] != 4 {
+		t.Errorf("widths[1] = %d, want 4 (max of \"City\" and \"東京\")", widths[1])
+	}
+}
+
+func TestAlignCountsRunesNotBytes(t *testing.T) {
+	if got := align("José", 6, AlignLeft); got != "José  " {
+		t.Errorf("align(José, 6, Left) = %q, want %q", got, "José  ")
+	}
+	if got := align("東京", 4, AlignRight); got != "  東京" {
+		t.Errorf("align(東京, 4, Right) = %q, want %q", got, "  東京")
+	}
+	if got := align("ab", 2, AlignCenter); got != "ab" {
+		t.Errorf("align(ab, 2, Center) = %q, want unchanged %q", got, "ab")
+	}
+}